@@ -5,20 +5,135 @@
 // Package configsbuilder provides a fluent interface for building application configurations.
 // It simplifies the process of loading configurations from environment variables and .env files
 // for various components of an application such as HTTP, messaging, databases, etc.
+//
+// Sources are merged in ascending precedence, each overriding the previous:
+//  1. struct-tag `default:` values
+//  2. /etc/<appName>/config.<ext> (system-wide install)
+//  3. ./<appName>.<ext> or WithConfigFile/CONFIG_FILE (per-workdir override)
+//  4. the .env file resolved for GO_ENV
+//  5. the remote config blob, when Remote or RemoteSource is used
+//  6. real process environment variables
+//  7. CLI flags bound via Flags, when set on the command line
 package configsbuilder
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/goxkit/configs"
 	noopLogging "github.com/goxkit/logging/noop"
 	otlpLogging "github.com/goxkit/logging/otlp"
+	otlpMetrics "github.com/goxkit/metrics/otlp"
 	otlpTracing "github.com/goxkit/tracing/otlp"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/stats"
 )
 
+// Configs wraps *configs.Configs with the extensions this package adds ahead of
+// github.com/goxkit/configs: the SQL-backed broker, RabbitMQ/MQTT dead-letter-queue
+// and per-handler retry settings, and the meter provider installed by Otlp. They live
+// here, rather than as fields on configs.Configs itself, because that struct belongs
+// to a separate module this repo doesn't own. Once equivalents land upstream, these
+// fields can move onto configs.Configs and this wrapper can go away; until then, every
+// *configs.Configs field (HTTPConfigs, AppConfigs, Logger, ...) is still reachable
+// directly off Configs through the embedded pointer.
+type Configs struct {
+	*configs.Configs
+
+	// SQLBrokerConfigs holds the settings for the Watermill-style SQL broker enabled
+	// via SQLBroker.
+	SQLBrokerConfigs *SQLBrokerConfigs
+	// RabbitMQDLQConfigs holds the dead-letter-queue and per-handler retry settings
+	// for the RabbitMQ broker enabled via RabbitMQ.
+	RabbitMQDLQConfigs *RabbitMQDLQConfigs
+	// MQTTDLQConfigs is the MQTT counterpart of RabbitMQDLQConfigs.
+	MQTTDLQConfigs *MQTTDLQConfigs
+	// MetricsProvider is the OTel meter provider installed by setupObservability when
+	// Otlp is enabled; nil otherwise.
+	MetricsProvider metric.MeterProvider
+}
+
+// SQLBrokerConfigs configures the Watermill-style SQL-backed message broker enabled
+// via SQLBroker, an alternative to RabbitMQ.
+type SQLBrokerConfigs struct {
+	Dialect     string `mapstructure:"SQL_BROKER_DIALECT" default:"postgres" usage:"SQL broker SQL dialect (postgres, mysql, ...)"`
+	Host        string `mapstructure:"SQL_BROKER_HOST" usage:"SQL broker host"`
+	Port        string `mapstructure:"SQL_BROKER_PORT" usage:"SQL broker port"`
+	User        string `mapstructure:"SQL_BROKER_USER" usage:"SQL broker user"`
+	Password    string `mapstructure:"SQL_BROKER_PASSWORD" usage:"SQL broker password"`
+	DbName      string `mapstructure:"SQL_BROKER_DB_NAME" usage:"SQL broker database name"`
+	QueryString string `mapstructure:"SQL_BROKER_QUERY_STRING" usage:"SQL broker connection query string"`
+	DLQExchange string `mapstructure:"SQL_BROKER_DLQ_EXCHANGE" usage:"SQL broker dead-letter-queue exchange"`
+	DLQQueue    string `mapstructure:"SQL_BROKER_DLQ_QUEUE" usage:"SQL broker dead-letter-queue queue"`
+	MaxRetries  int    `mapstructure:"SQL_BROKER_MAX_RETRIES" default:"0" usage:"SQL broker max delivery retries before dead-lettering"`
+	HandlerName string `mapstructure:"HANDLER_NAME" usage:"name of the handler this retry policy applies to"`
+}
+
+// RabbitMQDLQConfigs configures the dead-letter-queue and per-handler retry policy
+// for the RabbitMQ broker enabled via RabbitMQ.
+type RabbitMQDLQConfigs struct {
+	DLQExchange string `mapstructure:"RABBITMQ_DLQ_EXCHANGE" usage:"RabbitMQ dead-letter-queue exchange"`
+	DLQQueue    string `mapstructure:"RABBITMQ_DLQ_QUEUE" usage:"RabbitMQ dead-letter-queue queue"`
+	DLQTTL      int    `mapstructure:"RABBITMQ_DLQ_TTL" default:"0" usage:"RabbitMQ dead-letter-queue message TTL in milliseconds"`
+	MaxRetries  int    `mapstructure:"RABBITMQ_MAX_RETRIES" default:"0" usage:"RabbitMQ max delivery retries before dead-lettering"`
+	HandlerName string `mapstructure:"HANDLER_NAME" usage:"name of the handler this retry policy applies to"`
+}
+
+// MQTTDLQConfigs is the MQTT counterpart of RabbitMQDLQConfigs.
+type MQTTDLQConfigs struct {
+	DLQExchange string `mapstructure:"MQTT_DLQ_EXCHANGE" usage:"MQTT dead-letter-queue exchange"`
+	DLQQueue    string `mapstructure:"MQTT_DLQ_QUEUE" usage:"MQTT dead-letter-queue queue"`
+	DLQTTL      int    `mapstructure:"MQTT_DLQ_TTL" default:"0" usage:"MQTT dead-letter-queue message TTL in milliseconds"`
+	MaxRetries  int    `mapstructure:"MQTT_MAX_RETRIES" default:"0" usage:"MQTT max delivery retries before dead-lettering"`
+	HandlerName string `mapstructure:"HANDLER_NAME" usage:"name of the handler this retry policy applies to"`
+}
+
+// RemoteConfigSource fetches the raw config blob merged on top of the local config
+// sources by Remote/RemoteSource. Fetch is called once during the initial Build and
+// again on every background refresh tick started by watchRemote.
+type RemoteConfigSource interface {
+	Fetch() ([]byte, error)
+}
+
+// httpRemoteConfigSource is the RemoteConfigSource built by Remote: it issues a GET
+// against endpoint+path and returns the response body verbatim, which covers
+// Apollo-style (and most other) HTTP config endpoints without pulling in a
+// provider-specific client. Backends with their own client protocol, such as Consul
+// or etcd, should be wired up via RemoteSource with a custom RemoteConfigSource.
+type httpRemoteConfigSource struct {
+	endpoint string
+	path     string
+}
+
+func (s *httpRemoteConfigSource) Fetch() ([]byte, error) {
+	resp, err := http.Get(s.endpoint + s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote config endpoint returned status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
 type (
 	// ConfigsBuilder defines the interface for the builder pattern used to construct configurations.
 	// It provides methods to specify which configuration components should be included.
@@ -35,32 +150,102 @@ type (
 		MQTT() ConfigsBuilder
 		// RabbitMQ enables RabbitMQ configuration loading
 		RabbitMQ() ConfigsBuilder
+		// SQLBroker enables a Watermill-style SQL-backed message broker as an
+		// alternative to RabbitMQ
+		SQLBroker() ConfigsBuilder
 		// AWS enables AWS configuration loading
 		AWS() ConfigsBuilder
 		// DynamoDB enables DynamoDB configuration loading
 		DynamoDB() ConfigsBuilder
+		// WithConfigFile points the builder at a structured config file (YAML, JSON or TOML).
+		// The format is inferred from the file extension unless WithConfigFormat is also used.
+		WithConfigFile(path string) ConfigsBuilder
+		// WithConfigFormat overrides the format inference performed by WithConfigFile
+		// (e.g. "yaml", "json", "toml").
+		WithConfigFormat(format string) ConfigsBuilder
+		// Remote enables pulling a config blob from an HTTP endpoint at endpoint+path
+		// (the two strings concatenated as-is) and merging it on top of the local sources. provider is informational only;
+		// every call fetches over plain HTTP. For backends with their own client
+		// protocol (Consul, etcd, ...), use RemoteSource instead.
+		Remote(provider, endpoint, path string) ConfigsBuilder
+		// RemoteSource enables pulling a config blob from source -- e.g. a Consul or
+		// etcd client wrapped in a RemoteConfigSource -- and merging it on top of the
+		// local sources.
+		RemoteSource(source RemoteConfigSource) ConfigsBuilder
+		// WithRemoteFormat overrides the decode format used for the remote config blob
+		// (e.g. "yaml", "json"). Defaults to "json". Unlike WithConfigFormat, which only
+		// governs the local file sources, this never falls back to the file format --
+		// the two encodings are independent.
+		WithRemoteFormat(format string) ConfigsBuilder
+		// RemoteRefreshInterval overrides how often Build's background goroutine re-fetches
+		// the remote config blob. Only meaningful when Remote or RemoteSource was also called.
+		RemoteRefreshInterval(interval time.Duration) ConfigsBuilder
+		// Subscribe registers fn to be called, with the up-to-date *Configs, every
+		// time a remote config refresh picks up a change. Only meaningful when Remote or
+		// RemoteSource was also called.
+		Subscribe(fn func(*Configs)) ConfigsBuilder
+		// Flags binds fs into the builder's viper instance so CLI flags override every
+		// other source (e.g. --http.port=9090). A flag is auto-registered for every
+		// mapstructure-tagged field that doesn't already have one, using its `default`
+		// tag and an optional `usage` tag for --help text.
+		Flags(fs *pflag.FlagSet) ConfigsBuilder
 		// Build processes all enabled configurations and returns the complete config object
-		Build() (*configs.Configs, error)
+		Build() (*Configs, error)
+		// Close stops the background remote-refresh goroutine started by Build when
+		// Remote or RemoteSource was configured. Safe to call even when neither was
+		// used, or more than once.
+		Close()
 	}
 
 	// configsBuilder implements the ConfigsBuilder interface and tracks which configurations to load
 	configsBuilder struct {
 		Err error
 
-		http     bool
-		otlp     bool
-		postgres bool
-		identity bool
-		mqtt     bool
-		rabbitmq bool
-		aws      bool
-		dynamoDB bool
+		http      bool
+		otlp      bool
+		postgres  bool
+		identity  bool
+		mqtt      bool
+		rabbitmq  bool
+		sqlBroker bool
+		aws       bool
+		dynamoDB  bool
+
+		configFile   string
+		configFormat string
+
+		appName string
+
+		remoteSource   RemoteConfigSource
+		remoteFormat   string
+		remoteInterval time.Duration
+		subscribers    []func(*Configs)
+
+		remoteMu   sync.Mutex
+		remoteDone chan struct{}
+
+		flagSet *pflag.FlagSet
 	}
 )
 
-// NewConfigsBuilder creates a new instance of ConfigsBuilder with no configurations enabled
-func NewConfigsBuilder() ConfigsBuilder {
-	return &configsBuilder{}
+// configFileEnvVar is the environment variable used to point the builder at a
+// structured config file when WithConfigFile isn't called explicitly.
+const configFileEnvVar = "CONFIG_FILE"
+
+// defaultConfigFormat is the format assumed for the conventional /etc/<appName>/config.<ext>
+// and ./<appName>.<ext> layers when WithConfigFormat isn't used.
+const defaultConfigFormat = "yaml"
+
+// defaultRemoteRefreshInterval is how often Build's background goroutine re-fetches
+// the remote config blob when RemoteRefreshInterval isn't used.
+const defaultRemoteRefreshInterval = 30 * time.Second
+
+// NewConfigsBuilder creates a new instance of ConfigsBuilder with no configurations enabled.
+// appName is used to derive the conventional config file locations loaded by Build:
+// /etc/<appName>/config.<ext> for a system-wide install and ./<appName>.<ext> for a
+// per-workdir override.
+func NewConfigsBuilder(appName string) ConfigsBuilder {
+	return &configsBuilder{appName: appName}
 }
 
 // HTTP enables HTTP configuration loading in the builder
@@ -99,6 +284,13 @@ func (b *configsBuilder) RabbitMQ() ConfigsBuilder {
 	return b
 }
 
+// SQLBroker enables a Watermill-style SQL-backed message broker as an alternative
+// to RabbitMQ in the builder
+func (b *configsBuilder) SQLBroker() ConfigsBuilder {
+	b.sqlBroker = true
+	return b
+}
+
 // AWS enables AWS configuration loading in the builder
 func (b *configsBuilder) AWS() ConfigsBuilder {
 	b.aws = true
@@ -111,170 +303,562 @@ func (b *configsBuilder) DynamoDB() ConfigsBuilder {
 	return b
 }
 
+// WithConfigFile enables loading a structured config file (YAML, JSON or TOML) in the builder.
+// The format is inferred from the file extension unless WithConfigFormat is also used.
+func (b *configsBuilder) WithConfigFile(path string) ConfigsBuilder {
+	b.configFile = path
+	return b
+}
+
+// WithConfigFormat overrides the format inference performed from the config file extension.
+func (b *configsBuilder) WithConfigFormat(format string) ConfigsBuilder {
+	b.configFormat = format
+	return b
+}
+
+// Remote enables pulling a config blob from an HTTP endpoint at endpoint+path (the
+// two strings concatenated as-is) and merging it on top of the local sources.
+// provider is informational only.
+func (b *configsBuilder) Remote(provider, endpoint, path string) ConfigsBuilder {
+	b.remoteSource = &httpRemoteConfigSource{endpoint: endpoint, path: path}
+	return b
+}
+
+// RemoteSource enables pulling a config blob from source and merging it on top of
+// the local sources, for remote backends this package doesn't implement directly.
+func (b *configsBuilder) RemoteSource(source RemoteConfigSource) ConfigsBuilder {
+	b.remoteSource = source
+	return b
+}
+
+// WithRemoteFormat overrides the decode format used for the remote config blob.
+// Defaults to "json" when unset.
+func (b *configsBuilder) WithRemoteFormat(format string) ConfigsBuilder {
+	b.remoteFormat = format
+	return b
+}
+
+// RemoteRefreshInterval overrides the default polling interval used to re-fetch the
+// remote config blob once Build has started watching it.
+func (b *configsBuilder) RemoteRefreshInterval(interval time.Duration) ConfigsBuilder {
+	b.remoteInterval = interval
+	return b
+}
+
+// Subscribe registers fn to be called, with the up-to-date *Configs, every
+// time a remote config refresh picks up a change.
+func (b *configsBuilder) Subscribe(fn func(*Configs)) ConfigsBuilder {
+	b.subscribers = append(b.subscribers, fn)
+	return b
+}
+
+// Flags binds fs into the builder's viper instance so CLI flags override every
+// other source. loadStructDefaults auto-registers a flag on fs for every
+// mapstructure-tagged field it walks.
+func (b *configsBuilder) Flags(fs *pflag.FlagSet) ConfigsBuilder {
+	b.flagSet = fs
+	return b
+}
+
 // Build processes all enabled configurations and returns the complete configs object.
 // It reads environment variables, loads .env files, and constructs the configuration
-// based on the enabled features. Returns an error if any configuration fails to load.
-func (b *configsBuilder) Build() (*configs.Configs, error) {
+// based on the enabled features. Unlike a fail-fast pass, Build attempts every enabled
+// section and every `validate:` rule even after one fails, so a caller fixing a
+// misconfiguration sees every problem in a single run instead of one at a time. The
+// returned error, when non-nil, is always a *ConfigError.
+func (b *configsBuilder) Build() (*Configs, error) {
+	cfgErr := &ConfigError{}
+
 	cfgs, err := b.newConfigs()
 	if err != nil {
-		return nil, err
+		cfgErr.add(err)
+		return nil, cfgErr
+	}
+
+	if err := validateStruct(cfgs.AppConfigs); err != nil {
+		cfgErr.add(fmt.Errorf("App: %w", err))
+	}
+
+	if err := validateStruct(cfgs.OTLPConfigs); err != nil {
+		cfgErr.add(fmt.Errorf("OTLP: %w", err))
 	}
 
 	if err := b.setupObservability(cfgs); err != nil {
-		return nil, err
+		cfgErr.add(fmt.Errorf("observability: %w", err))
 	}
 
-	// Load component-specific configurations based on what was enabled
+	logger := bootstrapLogger(cfgs)
+	cfgs.Logger = logger
+
+	// Load component-specific configurations based on what was enabled. Every
+	// section is attempted regardless of earlier failures.
 	if b.http {
 		cfgs.HTTPConfigs = &configs.HTTPConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.HTTPConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.HTTPConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal HTTP configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.HTTPConfigs, logger, "HTTP"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
 	if b.postgres {
 		cfgs.PostgresConfigs = &configs.PostgresConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.PostgresConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.PostgresConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal Postgres configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.PostgresConfigs, logger, "Postgres"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
 	if b.identity {
 		cfgs.IdentityConfigs = &configs.IdentityConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.IdentityConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.IdentityConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal Identity configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.IdentityConfigs, logger, "Identity"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
 	if b.mqtt {
 		cfgs.MQTTConfigs = &configs.MQTTConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.MQTTConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.MQTTConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal MQTT configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.MQTTConfigs, logger, "MQTT"); err != nil {
+			cfgErr.add(err)
+		}
+
+		cfgs.MQTTDLQConfigs = &MQTTDLQConfigs{}
+		b.loadStructDefaults(cfgs.Custom, cfgs.MQTTDLQConfigs)
+		if err := b.loadSection(cfgs.Custom, cfgs.MQTTDLQConfigs, logger, "MQTTDLQ"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
 	if b.rabbitmq {
 		cfgs.RabbitMQConfigs = &configs.RabbitMQConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.RabbitMQConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.RabbitMQConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal RabbitMQ configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.RabbitMQConfigs, logger, "RabbitMQ"); err != nil {
+			cfgErr.add(err)
+		}
+
+		cfgs.RabbitMQDLQConfigs = &RabbitMQDLQConfigs{}
+		b.loadStructDefaults(cfgs.Custom, cfgs.RabbitMQDLQConfigs)
+		if err := b.loadSection(cfgs.Custom, cfgs.RabbitMQDLQConfigs, logger, "RabbitMQDLQ"); err != nil {
+			cfgErr.add(err)
+		}
+	}
+
+	if b.sqlBroker {
+		cfgs.SQLBrokerConfigs = &SQLBrokerConfigs{}
+		b.loadStructDefaults(cfgs.Custom, cfgs.SQLBrokerConfigs)
+		if err := b.loadSection(cfgs.Custom, cfgs.SQLBrokerConfigs, logger, "SQLBroker"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
 	if b.aws {
 		cfgs.AWSConfigs = &configs.AWSConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.AWSConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.AWSConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal AWS configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.AWSConfigs, logger, "AWS"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
 	if b.dynamoDB {
 		cfgs.DynamoDBConfigs = &configs.DynamoDBConfigs{}
 		b.loadStructDefaults(cfgs.Custom, cfgs.DynamoDBConfigs)
-		err = cfgs.Custom.Unmarshal(cfgs.DynamoDBConfigs)
-		if err != nil {
-			cfgs.Logger.Error("failed to unmarshal Dynamo configs", zap.Error(err))
-			return nil, err
+		if err := b.loadSection(cfgs.Custom, cfgs.DynamoDBConfigs, logger, "Dynamo"); err != nil {
+			cfgErr.add(err)
 		}
 	}
 
+	if err := b.validateDLQConfigs(cfgs); err != nil {
+		logger.Error("invalid dead-letter-queue configuration", zap.Error(err))
+		cfgErr.add(err)
+	}
+
+	if b.Err != nil {
+		cfgErr.add(b.Err)
+	}
+
+	if err := cfgErr.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	if b.remoteSource != nil {
+		b.watchRemote(cfgs)
+	}
+
 	return cfgs, nil
 }
 
-func (b *configsBuilder) newConfigs() (*configs.Configs, error) {
+// loadSection unmarshals v into sectionPtr and runs its `validate:` rules, logging
+// and wrapping any failure with name so it reads clearly inside a ConfigError.
+func (b *configsBuilder) loadSection(v *viper.Viper, sectionPtr interface{}, logger *zap.Logger, name string) error {
+	if err := v.Unmarshal(sectionPtr); err != nil {
+		logger.Error("failed to unmarshal "+name+" configs", zap.Error(err))
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	if err := validateStruct(sectionPtr); err != nil {
+		logger.Error("invalid "+name+" configs", zap.Error(err))
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (b *configsBuilder) newConfigs() (*Configs, error) {
 	v, err := b.setupViper()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("setup: %w", err)
 	}
 
 	appConfigs := &configs.AppConfigs{}
 	b.loadStructDefaults(v, appConfigs)
-	err = v.Unmarshal(appConfigs)
-	if err != nil {
-		return nil, err
+	if err := v.Unmarshal(appConfigs); err != nil {
+		return nil, fmt.Errorf("App: %w", err)
 	}
 
 	otlpConfigs := &configs.OTLPConfigs{}
 	b.loadStructDefaults(v, otlpConfigs)
-	err = v.Unmarshal(otlpConfigs)
-	if err != nil {
-		return nil, err
+	if err := v.Unmarshal(otlpConfigs); err != nil {
+		return nil, fmt.Errorf("OTLP: %w", err)
 	}
 
-	return &configs.Configs{
-		AppConfigs:  appConfigs,
-		OTLPConfigs: otlpConfigs,
-		Custom:      v,
+	return &Configs{
+		Configs: &configs.Configs{
+			AppConfigs:  appConfigs,
+			OTLPConfigs: otlpConfigs,
+			Custom:      v,
+		},
 	}, nil
 }
 
+// setupViper builds the *viper.Viper instance used to unmarshal every config section,
+// merging each layer described in the package doc in order. Missing files at the
+// system and per-workdir layers are not an error -- they're optional overrides --
+// only a parse failure aborts setup.
 func (c *configsBuilder) setupViper() (*viper.Viper, error) {
-	env := configs.NewEnvironment(os.Getenv("GO_ENV"))
-
 	v := viper.New()
+
+	format := c.configFormat
+	if format == "" {
+		format = defaultConfigFormat
+	}
+
+	systemFile := filepath.Join("/etc", c.appName, "config."+format)
+	if err := c.mergeOptionalFile(v, systemFile, format); err != nil {
+		return nil, err
+	}
+
+	localFile := c.configFile
+	if localFile == "" {
+		localFile = os.Getenv(configFileEnvVar)
+	}
+	localFormat := format
+	if localFile == "" {
+		localFile = c.appName + "." + format
+	} else {
+		localFormat = c.configFileFormat(localFile)
+	}
+	if err := c.mergeOptionalFile(v, localFile, localFormat); err != nil {
+		return nil, err
+	}
+
+	env := configs.NewEnvironment(os.Getenv("GO_ENV"))
 	v.SetConfigFile(env.EnvFile())
 	v.SetConfigType("env")
+	if err := v.MergeInConfig(); err != nil {
+		return nil, err
+	}
+
 	v.AutomaticEnv()
-	err := v.ReadInConfig()
-	if err != nil {
+
+	if err := c.mergeRemoteConfig(v); err != nil {
 		return nil, err
 	}
 
 	return v, nil
 }
 
-func (b *configsBuilder) setupObservability(cfgs *configs.Configs) error {
+// mergeRemoteConfig pulls the config blob from the RemoteConfigSource configured via
+// Remote/RemoteSource, if any, and merges it into v. The blob is decoded using
+// WithRemoteFormat (default "json") -- a setting independent of WithConfigFormat,
+// which only governs the local file sources. A remote read failure during the
+// initial Build is fatal; subsequent refreshes from watchRemote only log and keep
+// serving the last known-good config.
+func (c *configsBuilder) mergeRemoteConfig(v *viper.Viper) error {
+	if c.remoteSource == nil {
+		return nil
+	}
+
+	format := c.remoteFormat
+	if format == "" {
+		format = "json"
+	}
+
+	blob, err := c.remoteSource.Fetch()
+	if err != nil {
+		return err
+	}
+
+	rv := viper.New()
+	rv.SetConfigType(format)
+	if err := rv.ReadConfig(bytes.NewReader(blob)); err != nil {
+		return err
+	}
+
+	return v.MergeConfigMap(rv.AllSettings())
+}
+
+// watchRemote starts a background goroutine that periodically re-fetches the remote
+// config blob and, when the fetch actually changed something, swaps a freshly
+// unmarshaled struct onto each of cfgs' enabled sections under remoteMu so
+// concurrent refreshes never race each other. Swapping the pointer rather than
+// mutating the existing struct's fields in place means a caller that already holds
+// a *configs.HTTPConfigs (or other section) keeps reading a fully consistent
+// snapshot; callers that want the update should re-read cfgs.<Section>Configs, e.g.
+// from inside a Subscribe callback, rather than cache the pointer. Subscribe
+// callbacks fire only when a refresh picked up a real change. Close stops the
+// goroutine.
+func (b *configsBuilder) watchRemote(cfgs *Configs) {
+	interval := b.remoteInterval
+	if interval <= 0 {
+		interval = defaultRemoteRefreshInterval
+	}
+
+	b.remoteDone = make(chan struct{})
+	done := b.remoteDone
+
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+
+			case <-ticker.C:
+				before := cfgs.Custom.AllSettings()
+
+				if err := b.mergeRemoteConfig(cfgs.Custom); err != nil {
+					cfgs.Logger.Error("failed to refresh remote configs", zap.Error(err))
+					continue
+				}
+
+				if reflect.DeepEqual(before, cfgs.Custom.AllSettings()) {
+					continue
+				}
+
+				b.remoteMu.Lock()
+				b.reloadEnabledSections(cfgs)
+				b.remoteMu.Unlock()
+
+				for _, subscriber := range b.subscribers {
+					subscriber(cfgs)
+				}
+			}
+		}
+	}()
+}
+
+// Close stops the background remote-refresh goroutine started by Build when Remote
+// or RemoteSource was configured. It's a no-op when neither was used, or on a
+// second call.
+func (b *configsBuilder) Close() {
+	b.remoteMu.Lock()
+	done := b.remoteDone
+	b.remoteDone = nil
+	b.remoteMu.Unlock()
+
+	if done != nil {
+		close(done)
+	}
+}
+
+// reloadEnabledSections swaps a freshly unmarshaled struct onto every section
+// enabled on the builder, so a refreshed remote config reaches callers that
+// re-read cfgs.<Section>Configs after a refresh without ever seeing one update
+// field-by-field.
+func (b *configsBuilder) reloadEnabledSections(cfgs *Configs) {
+	if b.http {
+		reloadSection(cfgs, &cfgs.HTTPConfigs, "HTTP")
+	}
+	if b.postgres {
+		reloadSection(cfgs, &cfgs.PostgresConfigs, "Postgres")
+	}
+	if b.identity {
+		reloadSection(cfgs, &cfgs.IdentityConfigs, "Identity")
+	}
+	if b.mqtt {
+		reloadSection(cfgs, &cfgs.MQTTConfigs, "MQTT")
+		reloadSection(cfgs, &cfgs.MQTTDLQConfigs, "MQTTDLQ")
+	}
+	if b.rabbitmq {
+		reloadSection(cfgs, &cfgs.RabbitMQConfigs, "RabbitMQ")
+		reloadSection(cfgs, &cfgs.RabbitMQDLQConfigs, "RabbitMQDLQ")
+	}
+	if b.sqlBroker {
+		reloadSection(cfgs, &cfgs.SQLBrokerConfigs, "SQLBroker")
+	}
+	if b.aws {
+		reloadSection(cfgs, &cfgs.AWSConfigs, "AWS")
+	}
+	if b.dynamoDB {
+		reloadSection(cfgs, &cfgs.DynamoDBConfigs, "Dynamo")
+	}
+}
+
+// reloadSection unmarshals cfgs.Custom into a new T and swaps it onto *target as a
+// single pointer update, logging and leaving *target untouched on failure.
+func reloadSection[T any](cfgs *Configs, target **T, name string) {
+	section := new(T)
+	if err := cfgs.Custom.Unmarshal(section); err != nil {
+		cfgs.Logger.Error("failed to reload "+name+" configs after remote refresh", zap.Error(err))
+		return
+	}
+
+	*target = section
+}
+
+// mergeOptionalFile merges path into v using format as the Viper config type.
+// A missing file is treated as "nothing to override" rather than an error.
+func (c *configsBuilder) mergeOptionalFile(v *viper.Viper, path, format string) error {
+	if path == "" {
+		return nil
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	fv := viper.New()
+	fv.SetConfigFile(path)
+	fv.SetConfigType(format)
+	if err := fv.ReadInConfig(); err != nil {
+		return err
+	}
+
+	return v.MergeConfigMap(fv.AllSettings())
+}
+
+// configFileFormat resolves the Viper config type for path, preferring an explicit
+// WithConfigFormat over the file extension.
+func (c *configsBuilder) configFileFormat(path string) string {
+	if c.configFormat != "" {
+		return c.configFormat
+	}
+
+	return strings.TrimPrefix(filepath.Ext(path), ".")
+}
+
+func (b *configsBuilder) setupObservability(cfgs *Configs) error {
 	var err error
 
 	if b.otlp {
-		cfgs.Logger, err = otlpLogging.Install(cfgs)
+		cfgs.Logger, err = otlpLogging.Install(cfgs.Configs)
 		if err != nil {
-			cfgs.Logger.Error("failed to install OTLP logger", zap.Error(err))
+			bootstrapLogger(cfgs).Error("failed to install OTLP logger", zap.Error(err))
 			return err
 		}
 
-		_, err = otlpTracing.Install(cfgs)
+		_, err = otlpTracing.Install(cfgs.Configs)
 		if err != nil {
 			cfgs.Logger.Error("failed to install OTLP tracing", zap.Error(err))
 			return err
 		}
 
-		// _, err = otlpMetrics.Install(&cfgs)
-		// if err != nil {
-		// 	return nil, err
-		// }
+		meterProvider, err := otlpMetrics.Install(cfgs.Configs)
+		if err != nil {
+			cfgs.Logger.Error("failed to install OTLP metrics", zap.Error(err))
+			return err
+		}
+		cfgs.MetricsProvider = meterProvider
 
 		return nil
 	}
 
-	cfgs.Logger, err = noopLogging.Install(cfgs)
+	cfgs.Logger, err = noopLogging.Install(cfgs.Configs)
 	if err != nil {
-		cfgs.Logger.Error("failed to install Noop logger", zap.Error(err))
+		bootstrapLogger(cfgs).Error("failed to install Noop logger", zap.Error(err))
 		return err
 	}
 
 	return nil
 }
 
-// loadStructDefaults takes a struct and loads default values defined in 'default' tags
-// into the specified Viper instance. This allows setting defaults directly in struct tags.
+// validateDLQConfigs ensures every enabled broker with retries configured also has
+// a dead-letter-queue destination to send exhausted messages to.
+func (b *configsBuilder) validateDLQConfigs(cfgs *Configs) error {
+	var errs []error
+
+	if b.rabbitmq && cfgs.RabbitMQDLQConfigs != nil {
+		rmq := cfgs.RabbitMQDLQConfigs
+		errs = append(errs, validateDLQ("RabbitMQ", rmq.MaxRetries, rmq.DLQExchange, rmq.DLQQueue))
+	}
+
+	if b.mqtt && cfgs.MQTTDLQConfigs != nil {
+		mqtt := cfgs.MQTTDLQConfigs
+		errs = append(errs, validateDLQ("MQTT", mqtt.MaxRetries, mqtt.DLQExchange, mqtt.DLQQueue))
+	}
+
+	if b.sqlBroker && cfgs.SQLBrokerConfigs != nil {
+		sql := cfgs.SQLBrokerConfigs
+		errs = append(errs, validateDLQ("SQLBroker", sql.MaxRetries, sql.DLQExchange, sql.DLQQueue))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateDLQ returns an error when maxRetries is enabled but the dead-letter-queue
+// exchange/queue pair hasn't been configured to receive exhausted messages.
+func validateDLQ(broker string, maxRetries int, dlqExchange, dlqQueue string) error {
+	if maxRetries <= 0 {
+		return nil
+	}
+
+	if dlqExchange == "" || dlqQueue == "" {
+		return fmt.Errorf("%s: max retries is %d but DLQ exchange/queue is not configured", broker, maxRetries)
+	}
+
+	return nil
+}
+
+// GRPCClientStatsHandler returns the OTel gRPC client stats handler, so packages
+// dialing Postgres, RabbitMQ or DynamoDB over gRPC-based drivers can instrument
+// their connections uniformly. It's bound to cfgs.MetricsProvider, the meter
+// provider setupObservability installed when Otlp was enabled; when cfgs is nil or
+// Otlp was never enabled, it falls back to otelgrpc's default, the OTel global meter
+// provider (a no-op unless one has been registered globally), so callers never need
+// to nil-check.
+func GRPCClientStatsHandler(cfgs *Configs) stats.Handler {
+	return otelgrpc.NewClientHandler(grpcStatsHandlerOptions(cfgs)...)
+}
+
+// GRPCServerStatsHandler is the server-side counterpart of GRPCClientStatsHandler.
+func GRPCServerStatsHandler(cfgs *Configs) stats.Handler {
+	return otelgrpc.NewServerHandler(grpcStatsHandlerOptions(cfgs)...)
+}
+
+// grpcStatsHandlerOptions binds cfgs.MetricsProvider into the returned otelgrpc
+// options, if one was installed, so the stats handlers report through the same
+// meter provider as the rest of the application instead of OTel's global default.
+func grpcStatsHandlerOptions(cfgs *Configs) []otelgrpc.Option {
+	if cfgs == nil || cfgs.MetricsProvider == nil {
+		return nil
+	}
+
+	return []otelgrpc.Option{otelgrpc.WithMeterProvider(cfgs.MetricsProvider)}
+}
+
+// loadStructDefaults walks every mapstructure-tagged field of a struct, seeding its
+// Viper default from the field's 'default' tag when present. When Flags registered a
+// FlagSet, it also auto-registers and binds a matching CLI flag for every one of
+// those fields -- not just ones with a 'default' tag, so a required field with no
+// default (e.g. a secret) can still be overridden on the CLI -- using an optional
+// 'usage' tag for --help text.
 func (c *configsBuilder) loadStructDefaults(v *viper.Viper, structPtr interface{}) {
 	// Get the reflect Value and Type of the struct
 	val := reflect.ValueOf(structPtr)
@@ -293,19 +877,174 @@ func (c *configsBuilder) loadStructDefaults(v *viper.Viper, structPtr interface{
 	for i := 0; i < val.NumField(); i++ {
 		field := typ.Field(i)
 
-		// Get the default tag if it exists
-		defaultVal, ok := field.Tag.Lookup("default")
-		if !ok || defaultVal == "" {
-			continue
-		}
-
 		// Get the mapstructure tag which defines how viper maps the environment variable
 		envKey, ok := field.Tag.Lookup("mapstructure")
 		if !ok || envKey == "" {
 			continue
 		}
 
-		// Set the default value in Viper
-		v.SetDefault(envKey, defaultVal)
+		// Get the default tag if it exists; fields without one (e.g. required
+		// secrets) still get a flag registered, just with no default value.
+		defaultVal, hasDefault := field.Tag.Lookup("default")
+		if hasDefault && defaultVal != "" {
+			v.SetDefault(envKey, defaultVal)
+		}
+
+		c.bindFlag(v, field, envKey, defaultVal)
+	}
+}
+
+// bindFlag auto-registers a CLI flag on c.flagSet, if one isn't already defined,
+// and binds it into v under envKey so it takes precedence over every other source.
+// It's a no-op when Flags was never called. Any error binding the flag into v is
+// accumulated onto c.Err rather than discarded, surfacing through Build.
+func (c *configsBuilder) bindFlag(v *viper.Viper, field reflect.StructField, envKey, defaultVal string) {
+	if c.flagSet == nil {
+		return
+	}
+
+	name := flagName(envKey)
+
+	if c.flagSet.Lookup(name) == nil {
+		usage := field.Tag.Get("usage")
+		c.flagSet.String(name, defaultVal, usage)
+	}
+
+	if err := v.BindPFlag(envKey, c.flagSet.Lookup(name)); err != nil {
+		c.Err = errors.Join(c.Err, fmt.Errorf("bind flag %s: %w", name, err))
+	}
+}
+
+// flagName converts a mapstructure/env key such as "HTTP_PORT" into the dotted,
+// lower-case CLI flag name it's surfaced as, e.g. "http.port".
+func flagName(envKey string) string {
+	return strings.ToLower(strings.ReplaceAll(envKey, "_", "."))
+}
+
+// ConfigError aggregates every section and field-validation failure found while
+// building configs, so a caller sees every misconfiguration in a single run
+// instead of fixing them one at a time.
+type ConfigError struct {
+	Errors []error
+}
+
+// Error implements the error interface, joining every aggregated failure.
+func (e *ConfigError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("configsbuilder: %d configuration error(s): %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// add appends err to the aggregate, ignoring nil errors.
+func (e *ConfigError) add(err error) {
+	if err != nil {
+		e.Errors = append(e.Errors, err)
+	}
+}
+
+// errOrNil returns e as an error, or nil if nothing was ever added to it.
+func (e *ConfigError) errOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// bootstrapLogger returns cfgs.Logger, falling back to a standalone zap logger when
+// it hasn't been installed yet -- e.g. because setupObservability itself failed
+// before assigning one -- so later error logging never panics on a nil receiver.
+func bootstrapLogger(cfgs *Configs) *zap.Logger {
+	if cfgs.Logger != nil {
+		return cfgs.Logger
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return zap.NewNop()
+	}
+
+	return logger
+}
+
+// validateStruct walks structPtr's fields and enforces any `validate:"..."` tags
+// (currently "required", "url" and "min=<n>") against the values loaded into it by
+// loadStructDefaults and Unmarshal.
+func validateStruct(structPtr interface{}) error {
+	val := reflect.ValueOf(structPtr)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	typ := val.Type()
+	errs := &ConfigError{}
+
+	for i := 0; i < val.NumField(); i++ {
+		field := typ.Field(i)
+
+		rules, ok := field.Tag.Lookup("validate")
+		if !ok || rules == "" {
+			continue
+		}
+
+		for _, rule := range strings.Split(rules, ",") {
+			if err := applyValidationRule(field.Name, val.Field(i), rule); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+
+	return errs.errOrNil()
+}
+
+// applyValidationRule enforces a single `validate:` rule against fieldVal, returning
+// a descriptive error on violation.
+func applyValidationRule(fieldName string, fieldVal reflect.Value, rule string) error {
+	switch {
+	case rule == "required":
+		if fieldVal.IsZero() {
+			return fmt.Errorf("%s is required", fieldName)
+		}
+
+	case rule == "url":
+		if s, ok := fieldVal.Interface().(string); ok && s != "" {
+			if _, err := url.ParseRequestURI(s); err != nil {
+				return fmt.Errorf("%s must be a valid URL: %w", fieldName, err)
+			}
+		}
+
+	case strings.HasPrefix(rule, "min="):
+		min, err := strconv.ParseInt(strings.TrimPrefix(rule, "min="), 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		if !meetsMin(fieldVal, min) {
+			return fmt.Errorf("%s must be at least %d", fieldName, min)
+		}
+	}
+
+	return nil
+}
+
+// meetsMin reports whether fieldVal satisfies a `min=` rule: a numeric lower bound
+// for integers, or a minimum length for strings.
+func meetsMin(fieldVal reflect.Value, min int64) bool {
+	switch fieldVal.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fieldVal.Int() >= min
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return min <= 0 || fieldVal.Uint() >= uint64(min)
+	case reflect.String:
+		return int64(len(fieldVal.String())) >= min
+	default:
+		return true
 	}
 }